@@ -14,14 +14,19 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	mapiv1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
 	"github.com/openshift/machine-api-operator/pkg/controller/machine"
+	"k8s.io/client-go/discovery"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/rest"
 	"k8s.io/klog"
 	"k8s.io/klog/klogr"
 	machineactuator "sigs.k8s.io/cluster-api-provider-aws/pkg/actuators/machine"
@@ -29,17 +34,37 @@ import (
 	awsclient "sigs.k8s.io/cluster-api-provider-aws/pkg/client"
 	"sigs.k8s.io/cluster-api-provider-aws/pkg/version"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+const (
+	defaultLeaderElectionID            = "cluster-api-provider-aws-leader"
+	defaultLeaderElectionLeaseDuration = 15 * time.Second
+	defaultLeaderElectionRenewDeadline = 10 * time.Second
+	defaultLeaderElectionRetryPeriod   = 2 * time.Second
+	readinessCheckTimeout              = 500 * time.Millisecond
+)
+
 func main() {
 	var printVersion bool
 	flag.BoolVar(&printVersion, "version", false, "print version and exit")
 
 	klog.InitFlags(nil)
 	watchNamespace := flag.String("namespace", "", "Namespace that the controller watches to reconcile machine-api objects. If unspecified, the controller watches for machine-api objects across all namespaces.")
+	metricsAddr := flag.String("metrics-addr", ":8080", "The address the metric endpoint binds to. Set to \"0\" to disable metrics serving.")
+	healthAddr := flag.String("health-addr", ":9440", "The address for health checking.")
+	requeueAfter := flag.Duration("requeue-after", machineactuator.DefaultRequeueAfter, "Delay before requeueing a machine whose instance is pending or not yet visible due to eventual consistency. Also the window after a machine's last update during which a missing instance is treated as not yet visible rather than gone.")
+	requeueAfterFatal := flag.Duration("requeue-after-fatal", machineactuator.DefaultRequeueAfterFatal, "Delay before requeueing a machine whose instance has unexpectedly disappeared.")
+	leaderElect := flag.Bool("leader-elect", false, "Start a leader election client and gain leadership before executing the main loop. Enable this when running replicated components for high availability.")
+	leaderElectResourceNamespace := flag.String("leader-elect-resource-namespace", "", "The namespace of the resource object that is used for locking during leader election. If unspecified and running in cluster, defaults to the service account namespace for the controller. Required for leader election outside of a cluster.")
+	leaderElectResourceName := flag.String("leader-elect-resource-name", defaultLeaderElectionID, "The name of the resource object that is used for locking during leader election.")
+	leaderElectLeaseDuration := flag.Duration("leader-elect-lease-duration", defaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot.")
+	leaderElectRenewDeadline := flag.Duration("leader-elect-renew-deadline", defaultLeaderElectionRenewDeadline, "The interval between attempts by the acting master to renew a leadership slot before it stops leading. This must be less than the lease duration.")
+	leaderElectRetryPeriod := flag.Duration("leader-elect-retry-period", defaultLeaderElectionRetryPeriod, "The duration the clients should wait between attempting acquisition and renewal of a leadership. This must be less than the renew deadline.")
 	flag.Set("logtostderr", "true")
 	flag.Parse()
 
@@ -48,6 +73,24 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *requeueAfter <= 0 {
+		klog.Fatalf("Invalid --requeue-after %v: must be a positive duration", *requeueAfter)
+	}
+	if *requeueAfterFatal <= 0 {
+		klog.Fatalf("Invalid --requeue-after-fatal %v: must be a positive duration", *requeueAfterFatal)
+	}
+	if *leaderElect {
+		if *leaderElectRetryPeriod <= 0 {
+			klog.Fatalf("Invalid --leader-elect-retry-period %v: must be a positive duration", *leaderElectRetryPeriod)
+		}
+		if *leaderElectRenewDeadline <= *leaderElectRetryPeriod {
+			klog.Fatalf("Invalid --leader-elect-renew-deadline %v: must be greater than --leader-elect-retry-period %v", *leaderElectRenewDeadline, *leaderElectRetryPeriod)
+		}
+		if *leaderElectLeaseDuration <= *leaderElectRenewDeadline {
+			klog.Fatalf("Invalid --leader-elect-lease-duration %v: must be greater than --leader-elect-renew-deadline %v", *leaderElectLeaseDuration, *leaderElectRenewDeadline)
+		}
+	}
+
 	// Get a config to talk to the apiserver
 	cfg, err := config.GetConfig()
 	if err != nil {
@@ -57,9 +100,15 @@ func main() {
 	// Setup a Manager
 	syncPeriod := 10 * time.Minute
 	opts := manager.Options{
-		SyncPeriod: &syncPeriod,
-		// Disable metrics serving
-		MetricsBindAddress: "0",
+		SyncPeriod:              &syncPeriod,
+		MetricsBindAddress:      *metricsAddr,
+		HealthProbeBindAddress:  *healthAddr,
+		LeaderElection:          *leaderElect,
+		LeaderElectionNamespace: *leaderElectResourceNamespace,
+		LeaderElectionID:        *leaderElectResourceName,
+		LeaseDuration:           leaderElectLeaseDuration,
+		RenewDeadline:           leaderElectRenewDeadline,
+		RetryPeriod:             leaderElectRetryPeriod,
 	}
 	if *watchNamespace != "" {
 		opts.Namespace = *watchNamespace
@@ -78,9 +127,11 @@ func main() {
 
 	// Initialize machine actuator.
 	machineActuator := machineactuator.NewActuator(machineactuator.ActuatorParams{
-		Client:           mgr.GetClient(),
-		EventRecorder:    mgr.GetEventRecorderFor("awscontroller"),
-		AwsClientBuilder: awsclient.NewClient,
+		Client:            mgr.GetClient(),
+		EventRecorder:     mgr.GetEventRecorderFor("awscontroller"),
+		AwsClientBuilder:  awsclient.NewClient,
+		RequeueAfter:      *requeueAfter,
+		RequeueAfterFatal: *requeueAfterFatal,
 	})
 
 	if err := machine.AddWithActuator(mgr, machineActuator); err != nil {
@@ -96,9 +147,63 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "MachineSet")
 		os.Exit(1)
 	}
+
+	if err := addHealthChecks(mgr); err != nil {
+		klog.Fatalf("Error adding health checks: %v", err)
+	}
+
 	// Start the Cmd
 	err = mgr.Start(ctrl.SetupSignalHandler())
 	if err != nil {
 		klog.Fatalf("Error starting manager: %v", err)
 	}
 }
+
+// addHealthChecks registers the readiness and liveness checks served on the
+// manager's health probe address. The manager is only ready once its caches
+// have synced and the API server can be reached.
+func addHealthChecks(mgr manager.Manager) error {
+	cfg := rest.CopyConfig(mgr.GetConfig())
+	cfg.Timeout = readinessCheckTimeout
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to create discovery client: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("cache-sync", cacheSyncCheck(mgr.GetCache())); err != nil {
+		return fmt.Errorf("unable to add cache sync readiness check: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("api-server", apiServerCheck(discoveryClient)); err != nil {
+		return fmt.Errorf("unable to add API server readiness check: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to add health check: %w", err)
+	}
+
+	return nil
+}
+
+// cacheSyncCheck fails until all informers of the cache have synced.
+func cacheSyncCheck(c cache.Cache) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), readinessCheckTimeout)
+		defer cancel()
+
+		if !c.WaitForCacheSync(ctx.Done()) {
+			return errors.New("informer caches have not synced")
+		}
+		return nil
+	}
+}
+
+// apiServerCheck fails when the API server cannot be reached.
+func apiServerCheck(client discovery.ServerVersionInterface) healthz.Checker {
+	return func(_ *http.Request) error {
+		if _, err := client.ServerVersion(); err != nil {
+			return fmt.Errorf("unable to reach the API server: %w", err)
+		}
+		return nil
+	}
+}
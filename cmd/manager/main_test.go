@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestAddHealthChecks(t *testing.T) {
+	// apiServer only answers version requests, which is all the API server
+	// readiness check needs.
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"major": "1", "minor": "18", "gitVersion": "v1.18.0"}`)
+	}))
+	defer apiServer.Close()
+
+	testCases := []struct {
+		name           string
+		apiServerHost  string
+		expectedReadyz int
+	}{
+		{
+			name:           "not ready when the API server is unreachable",
+			apiServerHost:  "http://127.0.0.1:1",
+			expectedReadyz: http.StatusInternalServerError,
+		},
+		{
+			name:           "ready when the API server is reachable and the caches have synced",
+			apiServerHost:  apiServer.URL,
+			expectedReadyz: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// Reserve a free local port for the health probe endpoint.
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			g.Expect(err).ToNot(HaveOccurred())
+			healthAddr := listener.Addr().String()
+			g.Expect(listener.Close()).To(Succeed())
+
+			mgr, err := manager.New(&rest.Config{Host: tc.apiServerHost}, manager.Options{
+				MetricsBindAddress:     "0",
+				HealthProbeBindAddress: healthAddr,
+				// Avoid API discovery; readiness is what this test observes.
+				MapperProvider: func(c *rest.Config) (meta.RESTMapper, error) {
+					return meta.NewDefaultRESTMapper(nil), nil
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(addHealthChecks(mgr)).To(Succeed())
+
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				if err := mgr.Start(stop); err != nil {
+					t.Errorf("Error starting manager: %v", err)
+				}
+			}()
+
+			httpClient := &http.Client{Timeout: 2 * time.Second}
+			getStatusCode := func(endpoint string) func() (int, error) {
+				return func() (int, error) {
+					resp, err := httpClient.Get(fmt.Sprintf("http://%s%s", healthAddr, endpoint))
+					if err != nil {
+						return 0, err
+					}
+					defer resp.Body.Close()
+					return resp.StatusCode, nil
+				}
+			}
+
+			g.Eventually(getStatusCode("/healthz"), "10s").Should(Equal(http.StatusOK))
+			g.Eventually(getStatusCode("/readyz"), "10s").Should(Equal(tc.expectedReadyz))
+			g.Consistently(getStatusCode("/readyz"), "2s").Should(Equal(tc.expectedReadyz))
+		})
+	}
+}
+
+func TestCacheSyncCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	c, err := cache.New(&rest.Config{Host: "http://127.0.0.1:1"}, cache.Options{
+		Mapper: meta.NewDefaultRESTMapper(nil),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	check := cacheSyncCheck(c)
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	// A cache that has not been started has not synced.
+	g.Expect(check(req)).To(MatchError("informer caches have not synced"))
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		if err := c.Start(stop); err != nil {
+			t.Errorf("Error starting cache: %v", err)
+		}
+	}()
+
+	g.Eventually(func() error { return check(req) }, "10s").Should(Succeed())
+}
@@ -11,6 +11,8 @@ require (
 	github.com/onsi/gomega v1.8.1
 	github.com/openshift/machine-api-operator v0.2.1-0.20200402110321-4f3602b96da3
 	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v1.1.0
+	github.com/prometheus/client_model v0.2.0
 
 	// kube 1.18
 	k8s.io/api v0.18.0
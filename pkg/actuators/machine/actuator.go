@@ -19,6 +19,7 @@ package machine
 import (
 	"context"
 	"fmt"
+	"time"
 
 	machinev1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -39,9 +40,11 @@ const (
 
 // Actuator is responsible for performing machine reconciliation.
 type Actuator struct {
-	client           runtimeclient.Client
-	eventRecorder    record.EventRecorder
-	awsClientBuilder awsclient.AwsClientBuilderFuncType
+	client            runtimeclient.Client
+	eventRecorder     record.EventRecorder
+	awsClientBuilder  awsclient.AwsClientBuilderFuncType
+	requeueAfter      time.Duration
+	requeueAfterFatal time.Duration
 }
 
 // ActuatorParams holds parameter information for Actuator.
@@ -49,14 +52,20 @@ type ActuatorParams struct {
 	Client           runtimeclient.Client
 	EventRecorder    record.EventRecorder
 	AwsClientBuilder awsclient.AwsClientBuilderFuncType
+	// RequeueAfter defaults to DefaultRequeueAfter when unset or not positive.
+	RequeueAfter time.Duration
+	// RequeueAfterFatal defaults to DefaultRequeueAfterFatal when unset or not positive.
+	RequeueAfterFatal time.Duration
 }
 
 // NewActuator returns an actuator.
 func NewActuator(params ActuatorParams) *Actuator {
 	return &Actuator{
-		client:           params.Client,
-		eventRecorder:    params.EventRecorder,
-		awsClientBuilder: params.AwsClientBuilder,
+		client:            params.Client,
+		eventRecorder:     params.EventRecorder,
+		awsClientBuilder:  params.AwsClientBuilder,
+		requeueAfter:      params.RequeueAfter,
+		requeueAfterFatal: params.RequeueAfterFatal,
 	}
 }
 
@@ -71,13 +80,18 @@ func (a *Actuator) handleMachineError(machine *machinev1.Machine, err error, eve
 }
 
 // Create creates a machine and is invoked by the machine controller.
-func (a *Actuator) Create(ctx context.Context, machine *machinev1.Machine) error {
+func (a *Actuator) Create(ctx context.Context, machine *machinev1.Machine) (err error) {
 	klog.Infof("%s: actuator creating machine", machine.GetName())
+	machineOperationsTotal.WithLabelValues(createEventAction).Inc()
+	defer recordOperationFailure(createEventAction, &err)
+	start := time.Now()
 	scope, err := newMachineScope(machineScopeParams{
-		Context:          ctx,
-		client:           a.client,
-		machine:          machine,
-		awsClientBuilder: a.awsClientBuilder,
+		Context:           ctx,
+		client:            a.client,
+		machine:           machine,
+		awsClientBuilder:  a.awsClientBuilder,
+		requeueAfter:      a.requeueAfter,
+		requeueAfterFatal: a.requeueAfterFatal,
 	})
 	if err != nil {
 		fmtErr := fmt.Errorf(scopeFailFmt, machine.GetName(), err)
@@ -87,11 +101,19 @@ func (a *Actuator) Create(ctx context.Context, machine *machinev1.Machine) error
 		if err := scope.patchMachine(); err != nil {
 			return err
 		}
+		if isRequeueAfterError(err) {
+			// The instance was launched but is not running yet.
+			machineCreateDurationSeconds.Observe(time.Since(start).Seconds())
+		}
 		fmtErr := fmt.Errorf(reconcilerFailFmt, machine.GetName(), createEventAction, err)
 		return a.handleMachineError(machine, fmtErr, createEventAction)
 	}
 	a.eventRecorder.Eventf(machine, corev1.EventTypeNormal, createEventAction, "Created Machine %v", machine.GetName())
-	return scope.patchMachine()
+	if err := scope.patchMachine(); err != nil {
+		return err
+	}
+	machineCreateDurationSeconds.Observe(time.Since(start).Seconds())
+	return nil
 }
 
 // Exists determines if the given machine currently exists.
@@ -99,10 +121,12 @@ func (a *Actuator) Create(ctx context.Context, machine *machinev1.Machine) error
 func (a *Actuator) Exists(ctx context.Context, machine *machinev1.Machine) (bool, error) {
 	klog.Infof("%s: actuator checking if machine exists", machine.GetName())
 	scope, err := newMachineScope(machineScopeParams{
-		Context:          ctx,
-		client:           a.client,
-		machine:          machine,
-		awsClientBuilder: a.awsClientBuilder,
+		Context:           ctx,
+		client:            a.client,
+		machine:           machine,
+		awsClientBuilder:  a.awsClientBuilder,
+		requeueAfter:      a.requeueAfter,
+		requeueAfterFatal: a.requeueAfterFatal,
 	})
 	if err != nil {
 		return false, fmt.Errorf(scopeFailFmt, machine.GetName(), err)
@@ -111,13 +135,17 @@ func (a *Actuator) Exists(ctx context.Context, machine *machinev1.Machine) (bool
 }
 
 // Update attempts to sync machine state with an existing instance.
-func (a *Actuator) Update(ctx context.Context, machine *machinev1.Machine) error {
+func (a *Actuator) Update(ctx context.Context, machine *machinev1.Machine) (err error) {
 	klog.Infof("%s: actuator updating machine", machine.GetName())
+	machineOperationsTotal.WithLabelValues(updateEventAction).Inc()
+	defer recordOperationFailure(updateEventAction, &err)
 	scope, err := newMachineScope(machineScopeParams{
-		Context:          ctx,
-		client:           a.client,
-		machine:          machine,
-		awsClientBuilder: a.awsClientBuilder,
+		Context:           ctx,
+		client:            a.client,
+		machine:           machine,
+		awsClientBuilder:  a.awsClientBuilder,
+		requeueAfter:      a.requeueAfter,
+		requeueAfterFatal: a.requeueAfterFatal,
 	})
 	if err != nil {
 		fmtErr := fmt.Errorf(scopeFailFmt, machine.GetName(), err)
@@ -149,13 +177,17 @@ func (a *Actuator) Update(ctx context.Context, machine *machinev1.Machine) error
 }
 
 // Delete deletes a machine and updates its finalizer
-func (a *Actuator) Delete(ctx context.Context, machine *machinev1.Machine) error {
+func (a *Actuator) Delete(ctx context.Context, machine *machinev1.Machine) (err error) {
 	klog.Infof("%s: actuator deleting machine", machine.GetName())
+	machineOperationsTotal.WithLabelValues(deleteEventAction).Inc()
+	defer recordOperationFailure(deleteEventAction, &err)
 	scope, err := newMachineScope(machineScopeParams{
-		Context:          ctx,
-		client:           a.client,
-		machine:          machine,
-		awsClientBuilder: a.awsClientBuilder,
+		Context:           ctx,
+		client:            a.client,
+		machine:           machine,
+		awsClientBuilder:  a.awsClientBuilder,
+		requeueAfter:      a.requeueAfter,
+		requeueAfterFatal: a.requeueAfterFatal,
 	})
 	if err != nil {
 		fmtErr := fmt.Errorf(scopeFailFmt, machine.GetName(), err)
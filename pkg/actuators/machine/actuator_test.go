@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	machinev1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -47,6 +50,13 @@ func TestMachineEvents(t *testing.T) {
 		event               string
 		awsError            bool
 		invalidMachineScope bool
+		pendingInstance     bool
+		machineNotStored    bool
+		// metricsOperation, when set, is the operation whose metrics are
+		// checked after running the case once.
+		metricsOperation   string
+		expectFailure      bool
+		expectCreateSample bool
 	}{
 		{
 			name: "Create machine event failed on invalid machine scope",
@@ -56,6 +66,8 @@ func TestMachineEvents(t *testing.T) {
 			event:               "aws-actuator-testing-machine: failed to create scope for machine: failed to create aws client: AWS client error",
 			invalidMachineScope: true,
 			awsError:            false,
+			metricsOperation:    createEventAction,
+			expectFailure:       true,
 		},
 		{
 			name: "Create machine event failed, reconciler's create failed",
@@ -65,6 +77,8 @@ func TestMachineEvents(t *testing.T) {
 			event:               "aws-actuator-testing-machine: reconciler failed to Create machine: unable to remove stopped machines: error getting stopped instances: AWS error",
 			invalidMachineScope: false,
 			awsError:            true,
+			metricsOperation:    createEventAction,
+			expectFailure:       true,
 		},
 		{
 			name: "Create machine event succeed",
@@ -74,6 +88,28 @@ func TestMachineEvents(t *testing.T) {
 			event:               "Created Machine aws-actuator-testing-machine",
 			invalidMachineScope: false,
 			awsError:            false,
+			metricsOperation:    createEventAction,
+			expectCreateSample:  true,
+		},
+		{
+			name: "Create machine requeued while the instance is pending",
+			operation: func(actuator *Actuator, machine *machinev1.Machine) {
+				actuator.Create(context.TODO(), machine)
+			},
+			event:              "aws-actuator-testing-machine: reconciler failed to Create machine: requeue in: 20s",
+			pendingInstance:    true,
+			metricsOperation:   createEventAction,
+			expectCreateSample: true,
+		},
+		{
+			name: "Create machine event succeed, machine patch failed",
+			operation: func(actuator *Actuator, machine *machinev1.Machine) {
+				actuator.Create(context.TODO(), machine)
+			},
+			event:            "Created Machine aws-actuator-testing-machine",
+			machineNotStored: true,
+			metricsOperation: createEventAction,
+			expectFailure:    true,
 		},
 		{
 			name: "Update machine event failed on invalid machine scope",
@@ -92,6 +128,8 @@ func TestMachineEvents(t *testing.T) {
 			event:               "aws-actuator-testing-machine: reconciler failed to Update machine: AWS error",
 			invalidMachineScope: false,
 			awsError:            true,
+			metricsOperation:    updateEventAction,
+			expectFailure:       true,
 		},
 		{
 			name: "Update machine event succeed and only one event is created",
@@ -118,6 +156,8 @@ func TestMachineEvents(t *testing.T) {
 			event:               "aws-actuator-testing-machine: reconciler failed to Delete machine: AWS error",
 			invalidMachineScope: false,
 			awsError:            true,
+			metricsOperation:    deleteEventAction,
+			expectFailure:       true,
 		},
 		{
 			name: "Delete machine event succeed",
@@ -127,6 +167,7 @@ func TestMachineEvents(t *testing.T) {
 			event:               "Deleted machine aws-actuator-testing-machine",
 			invalidMachineScope: false,
 			awsError:            false,
+			metricsOperation:    deleteEventAction,
 		},
 	}
 
@@ -139,18 +180,20 @@ func TestMachineEvents(t *testing.T) {
 			gs.Expect(err).ToNot(HaveOccurred())
 			gs.Expect(stubMachine).ToNot(BeNil())
 
-			// Create the machine
-			gs.Expect(k8sClient.Create(ctx, machine)).To(Succeed())
-			defer func() {
-				gs.Expect(k8sClient.Delete(ctx, machine)).To(Succeed())
-			}()
+			if !tc.machineNotStored {
+				// Create the machine
+				gs.Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+				defer func() {
+					gs.Expect(k8sClient.Delete(ctx, machine)).To(Succeed())
+				}()
 
-			// Ensure the machine has synced to the cache
-			getMachine := func() error {
-				machineKey := types.NamespacedName{Namespace: machine.Namespace, Name: machine.Name}
-				return k8sClient.Get(ctx, machineKey, machine)
+				// Ensure the machine has synced to the cache
+				getMachine := func() error {
+					machineKey := types.NamespacedName{Namespace: machine.Namespace, Name: machine.Name}
+					return k8sClient.Get(ctx, machineKey, machine)
+				}
+				gs.Eventually(getMachine, timeout).Should(Succeed())
 			}
-			gs.Eventually(getMachine, timeout).Should(Succeed())
 
 			mockCtrl := gomock.NewController(t)
 			mockAWSClient := mockaws.NewMockClient(mockCtrl)
@@ -169,7 +212,12 @@ func TestMachineEvents(t *testing.T) {
 				mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(stubDescribeInstancesOutput("ami-a9acbbd6", "i-02fcb933c5da7085c", ec2.InstanceStateNameRunning), nil).AnyTimes()
 			}
 
-			mockAWSClient.EXPECT().RunInstances(gomock.Any()).Return(stubReservation("ami-a9acbbd6", "i-02fcb933c5da7085c"), nil).AnyTimes()
+			reservation := stubReservation("ami-a9acbbd6", "i-02fcb933c5da7085c")
+			if tc.pendingInstance {
+				reservation.Instances[0].State.Name = aws.String(ec2.InstanceStateNamePending)
+			}
+
+			mockAWSClient.EXPECT().RunInstances(gomock.Any()).Return(reservation, nil).AnyTimes()
 			mockAWSClient.EXPECT().TerminateInstances(gomock.Any()).Return(&ec2.TerminateInstancesOutput{}, nil)
 			mockAWSClient.EXPECT().RegisterInstancesWithLoadBalancer(gomock.Any()).Return(nil, nil).AnyTimes()
 			mockAWSClient.EXPECT().TerminateInstances(gomock.Any()).Return(&ec2.TerminateInstancesOutput{}, nil).AnyTimes()
@@ -184,8 +232,32 @@ func TestMachineEvents(t *testing.T) {
 				AwsClientBuilder: awsClientBuilder,
 			}
 			actuator := NewActuator(params)
+
+			var operationsBefore, failuresBefore float64
+			var createSamplesBefore uint64
+			if tc.metricsOperation != "" {
+				operationsBefore = counterValue(gs, machineOperationsTotal.WithLabelValues(tc.metricsOperation))
+				failuresBefore = counterValue(gs, machineOperationFailuresTotal.WithLabelValues(tc.metricsOperation))
+				createSamplesBefore = histogramSampleCount(gs, machineCreateDurationSeconds)
+			}
+
 			tc.operation(actuator, machine)
 
+			if tc.metricsOperation != "" {
+				expectedFailures := 0.0
+				if tc.expectFailure {
+					expectedFailures = 1
+				}
+				expectedCreateSamples := uint64(0)
+				if tc.expectCreateSample {
+					expectedCreateSamples = 1
+				}
+
+				gs.Expect(counterValue(gs, machineOperationsTotal.WithLabelValues(tc.metricsOperation)) - operationsBefore).To(Equal(1.0))
+				gs.Expect(counterValue(gs, machineOperationFailuresTotal.WithLabelValues(tc.metricsOperation)) - failuresBefore).To(Equal(expectedFailures))
+				gs.Expect(histogramSampleCount(gs, machineCreateDurationSeconds) - createSamplesBefore).To(Equal(expectedCreateSamples))
+			}
+
 			eventList := &v1.EventList{}
 			waitForEvent := func() error {
 				gs.Expect(k8sClient.List(ctx, eventList, client.InNamespace(machine.Namespace))).To(Succeed())
@@ -257,3 +329,15 @@ func TestHandleMachineErrors(t *testing.T) {
 		})
 	}
 }
+
+func counterValue(g *WithT, counter prometheus.Counter) float64 {
+	metric := &dto.Metric{}
+	g.Expect(counter.Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+func histogramSampleCount(g *WithT, histogram prometheus.Histogram) uint64 {
+	metric := &dto.Metric{}
+	g.Expect(histogram.Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
@@ -3,6 +3,7 @@ package machine
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	machinev1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
@@ -27,6 +28,11 @@ type machineScopeParams struct {
 	client runtimeclient.Client
 	// machine resource
 	machine *machinev1.Machine
+	// delay before requeueing on pending or eventually consistent instances,
+	// also used as the eventual-consistency window for missing instances
+	requeueAfter time.Duration
+	// delay before requeueing when the instance is unrecoverably gone
+	requeueAfterFatal time.Duration
 }
 
 type machineScope struct {
@@ -41,6 +47,8 @@ type machineScope struct {
 	machineToBePatched runtimeclient.Patch
 	providerSpec       *awsproviderv1.AWSMachineProviderConfig
 	providerStatus     *awsproviderv1.AWSMachineProviderStatus
+	requeueAfter       time.Duration
+	requeueAfterFatal  time.Duration
 }
 
 func newMachineScope(params machineScopeParams) (*machineScope, error) {
@@ -64,6 +72,16 @@ func newMachineScope(params machineScopeParams) (*machineScope, error) {
 		return nil, machineapierros.InvalidMachineConfiguration("failed to create aws client: %v", err.Error())
 	}
 
+	requeueAfter := params.requeueAfter
+	if requeueAfter <= 0 {
+		requeueAfter = DefaultRequeueAfter
+	}
+
+	requeueAfterFatal := params.requeueAfterFatal
+	if requeueAfterFatal <= 0 {
+		requeueAfterFatal = DefaultRequeueAfterFatal
+	}
+
 	return &machineScope{
 		Context:            params.Context,
 		awsClient:          awsClient,
@@ -72,6 +90,8 @@ func newMachineScope(params machineScopeParams) (*machineScope, error) {
 		machineToBePatched: runtimeclient.MergeFrom(params.machine.DeepCopy()),
 		providerSpec:       providerSpec,
 		providerStatus:     providerStatus,
+		requeueAfter:       requeueAfter,
+		requeueAfterFatal:  requeueAfterFatal,
 	}, nil
 }
 
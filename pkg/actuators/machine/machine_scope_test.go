@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	machinev1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
@@ -140,6 +141,56 @@ func TestGetUserData(t *testing.T) {
 	}
 }
 
+func TestNewMachineScopeRequeueIntervals(t *testing.T) {
+	testCases := []struct {
+		testCase                  string
+		requeueAfter              time.Duration
+		requeueAfterFatal         time.Duration
+		expectedRequeueAfter      time.Duration
+		expectedRequeueAfterFatal time.Duration
+	}{
+		{
+			testCase:                  "unset intervals use defaults",
+			expectedRequeueAfter:      DefaultRequeueAfter,
+			expectedRequeueAfterFatal: DefaultRequeueAfterFatal,
+		},
+		{
+			testCase:                  "negative intervals use defaults",
+			requeueAfter:              -5 * time.Second,
+			requeueAfterFatal:         -10 * time.Minute,
+			expectedRequeueAfter:      DefaultRequeueAfter,
+			expectedRequeueAfterFatal: DefaultRequeueAfterFatal,
+		},
+		{
+			testCase:                  "configured intervals are kept",
+			requeueAfter:              5 * time.Second,
+			requeueAfterFatal:         10 * time.Minute,
+			expectedRequeueAfter:      5 * time.Second,
+			expectedRequeueAfterFatal: 10 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testCase, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms, err := newMachineScope(machineScopeParams{
+				Context: context.Background(),
+				client:  fake.NewFakeClient(),
+				machine: machineWithSpec(&awsproviderv1.AWSMachineProviderConfig{}),
+				awsClientBuilder: func(client runtimeclient.Client, secretName, namespace, region string) (awsclient.Client, error) {
+					return nil, nil
+				},
+				requeueAfter:      tc.requeueAfter,
+				requeueAfterFatal: tc.requeueAfterFatal,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(ms.requeueAfter).To(Equal(tc.expectedRequeueAfter))
+			g.Expect(ms.requeueAfterFatal).To(Equal(tc.expectedRequeueAfterFatal))
+		})
+	}
+}
+
 func TestPatchMachine(t *testing.T) {
 	g := NewWithT(t)
 
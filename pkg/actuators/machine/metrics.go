@@ -0,0 +1,69 @@
+package machine
+
+import (
+	"errors"
+
+	machinecontroller "github.com/openshift/machine-api-operator/pkg/controller/machine"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// machineOperationsTotal counts the Create, Update and Delete operations
+	// attempted by the actuator.
+	machineOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aws_machine_operations_total",
+			Help: "Total number of Create, Update and Delete operations attempted by the AWS machine actuator.",
+		},
+		[]string{"operation"},
+	)
+
+	// machineOperationFailuresTotal counts the Create, Update and Delete
+	// operations that returned an error.
+	machineOperationFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aws_machine_operation_failures_total",
+			Help: "Total number of Create, Update and Delete operations of the AWS machine actuator that failed.",
+		},
+		[]string{"operation"},
+	)
+
+	// machineCreateDurationSeconds tracks how long successful creates take,
+	// from building the machine scope to the launched instance being recorded
+	// on the machine. The instance does not need to be running yet.
+	machineCreateDurationSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "aws_machine_create_duration_seconds",
+			Help:    "Duration in seconds of successful AWS machine creates.",
+			Buckets: prometheus.ExponentialBuckets(0.5, 2, 10),
+		},
+	)
+)
+
+func init() {
+	// Register with the controller-runtime registry so the metrics are served
+	// on the manager's metrics endpoint.
+	metrics.Registry.MustRegister(
+		machineOperationsTotal,
+		machineOperationFailuresTotal,
+		machineCreateDurationSeconds,
+	)
+}
+
+// recordOperationFailure increments the failure counter for operation when
+// *err is set and is not a requeue request. It is meant to be deferred with a
+// named error result so that every error path of an actuator operation is
+// counted.
+func recordOperationFailure(operation string, err *error) {
+	if *err != nil && !isRequeueAfterError(*err) {
+		machineOperationFailuresTotal.WithLabelValues(operation).Inc()
+	}
+}
+
+// isRequeueAfterError returns true if err wraps a RequeueAfterError, which the
+// machine controller treats as a delayed retry rather than a failure.
+func isRequeueAfterError(err error) bool {
+	var requeueErr *machinecontroller.RequeueAfterError
+	return errors.As(err, &requeueErr)
+}
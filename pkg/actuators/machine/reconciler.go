@@ -16,8 +16,14 @@ import (
 )
 
 const (
-	requeueAfterSeconds      = 20
-	requeueAfterFatalSeconds = 180
+	// DefaultRequeueAfter is the default delay before requeueing a machine whose
+	// instance is pending or possibly not yet visible due to eventual consistency.
+	// It is also the window after the machine's last update during which a
+	// missing instance is treated as not yet visible rather than gone.
+	DefaultRequeueAfter = 20 * time.Second
+	// DefaultRequeueAfterFatal is the default delay before requeueing a machine
+	// whose instance has disappeared, to minimize unnecessary API calls.
+	DefaultRequeueAfterFatal = 180 * time.Second
 	masterLabel              = "node-role.kubernetes.io/master"
 )
 
@@ -142,9 +148,9 @@ func (r *Reconciler) update() error {
 
 	existingLen := len(existingInstances)
 	if existingLen == 0 {
-		if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && (r.machine.Status.LastUpdated == nil || r.machine.Status.LastUpdated.Add(requeueAfterSeconds*time.Second).After(time.Now())) {
+		if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && (r.machine.Status.LastUpdated == nil || r.machine.Status.LastUpdated.Add(r.requeueAfter).After(time.Now())) {
 			klog.Infof("%s: Possible eventual-consistency discrepancy; returning an error to requeue", r.machine.Name)
-			return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
+			return &machinecontroller.RequeueAfterError{RequeueAfter: r.requeueAfter}
 		}
 
 		klog.Warningf("%s: attempted to update machine but no instances found", r.machine.Name)
@@ -153,7 +159,7 @@ func (r *Reconciler) update() error {
 		r.machineScope.setProviderStatus(nil, conditionSuccess())
 		// This is an unrecoverable error condition.  We should delay to
 		// minimize unnecessary API calls.
-		return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterFatalSeconds * time.Second}
+		return &machinecontroller.RequeueAfterError{RequeueAfter: r.requeueAfterFatal}
 	}
 
 	sortInstances(existingInstances)
@@ -202,9 +208,9 @@ func (r *Reconciler) exists() (bool, error) {
 	}
 
 	if len(existingInstances) == 0 {
-		if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && (r.machine.Status.LastUpdated == nil || r.machine.Status.LastUpdated.Add(requeueAfterSeconds*time.Second).After(time.Now())) {
+		if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && (r.machine.Status.LastUpdated == nil || r.machine.Status.LastUpdated.Add(r.requeueAfter).After(time.Now())) {
 			klog.Infof("%s: Possible eventual-consistency discrepancy; returning an error to requeue", r.machine.Name)
-			return false, &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
+			return false, &machinecontroller.RequeueAfterError{RequeueAfter: r.requeueAfter}
 		}
 
 		klog.Infof("%s: Instance does not exist", r.machine.Name)
@@ -349,7 +355,7 @@ func (r *Reconciler) requeueIfInstancePending(instance *ec2.Instance) error {
 	// we get a public IP populated more quickly.
 	if instance.State != nil && *instance.State.Name == ec2.InstanceStateNamePending {
 		klog.Infof("%s: Instance state still pending, returning an error to requeue", r.machine.Name)
-		return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
+		return &machinecontroller.RequeueAfterError{RequeueAfter: r.requeueAfter}
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/golang/mock/gomock"
 	machinev1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	machinecontroller "github.com/openshift/machine-api-operator/pkg/controller/machine"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -654,3 +655,84 @@ func TestGetMachineInstances(t *testing.T) {
 		})
 	}
 }
+
+func TestRequeueIntervals(t *testing.T) {
+	requeueAfter := 7 * time.Second
+	requeueAfterFatal := 3 * time.Minute
+
+	testCases := []struct {
+		testcase             string
+		providerID           *string
+		describeOutput       *ec2.DescribeInstancesOutput
+		operation            func(r *Reconciler) error
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			testcase:       "update requeues a pending instance after requeueAfter",
+			describeOutput: stubDescribeInstancesOutput("ami-a9acbbd6", "i-02fcb933c5da7085c", ec2.InstanceStateNamePending),
+			operation: func(r *Reconciler) error {
+				return r.update()
+			},
+			expectedRequeueAfter: requeueAfter,
+		},
+		{
+			testcase:       "update requeues a missing instance after requeueAfterFatal",
+			describeOutput: &ec2.DescribeInstancesOutput{},
+			operation: func(r *Reconciler) error {
+				return r.update()
+			},
+			expectedRequeueAfter: requeueAfterFatal,
+		},
+		{
+			testcase:       "exists requeues a not yet visible instance after requeueAfter",
+			providerID:     aws.String("aws:///us-east-1a/i-02fcb933c5da7085c"),
+			describeOutput: &ec2.DescribeInstancesOutput{},
+			operation: func(r *Reconciler) error {
+				_, err := r.exists()
+				return err
+			},
+			expectedRequeueAfter: requeueAfter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testcase, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			machine, err := stubMachine()
+			if err != nil {
+				t.Fatal(err)
+			}
+			machine.Spec.ProviderID = tc.providerID
+
+			mockAWSClient := mockaws.NewMockClient(ctrl)
+			mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(tc.describeOutput, nil).AnyTimes()
+
+			fakeClient := fake.NewFakeClientWithScheme(scheme.Scheme, machine, stubAwsCredentialsSecret(), stubUserDataSecret())
+
+			machineScope, err := newMachineScope(machineScopeParams{
+				client:  fakeClient,
+				machine: machine,
+				awsClientBuilder: func(client runtimeclient.Client, secretName, namespace, region string) (awsclient.Client, error) {
+					return mockAWSClient, nil
+				},
+				requeueAfter:      requeueAfter,
+				requeueAfterFatal: requeueAfterFatal,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = tc.operation(newReconciler(machineScope))
+
+			var requeueErr *machinecontroller.RequeueAfterError
+			if !errors.As(err, &requeueErr) {
+				t.Fatalf("Expected RequeueAfterError, got %v", err)
+			}
+			if requeueErr.RequeueAfter != tc.expectedRequeueAfter {
+				t.Errorf("Expected requeue after %v, got %v", tc.expectedRequeueAfter, requeueErr.RequeueAfter)
+			}
+		})
+	}
+}